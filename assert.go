@@ -0,0 +1,73 @@
+package vala
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// TB is the subset of testing.TB that Assert and Require need, so callers
+// don't have to depend on the testing package to use them (and so fakes are
+// easy to write in tests of code that itself calls Assert/Require).
+type TB interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+}
+
+func callerInfo() string {
+	_, file, line, ok := runtime.Caller(2)
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", file, line)
+}
+
+// Assert runs checkers and reports any failures to t via t.Errorf, allowing
+// the calling test to continue. nameOrErr handling is exactly as elsewhere
+// in vala; the failure message is additionally prefixed with the file:line
+// of the Assert call itself.
+func Assert(t TB, checkers ...Checker) {
+	t.Helper()
+	if err := Begin().Validate(checkers...).Check(); err != nil {
+		t.Errorf("%s: %s", callerInfo(), err)
+	}
+}
+
+// Require runs checkers and reports any failures to t via t.Fatalf,
+// stopping the calling test immediately. nameOrErr handling is exactly as
+// elsewhere in vala; the failure message is additionally prefixed with the
+// file:line of the Require call itself.
+func Require(t TB, checkers ...Checker) {
+	t.Helper()
+	if err := Begin().Validate(checkers...).Check(); err != nil {
+		t.Fatalf("%s: %s", callerInfo(), err)
+	}
+}
+
+// Group runs checkers as a single Checker, prefixing the Name of the first
+// resulting CheckerError with "name." so failures from table-driven tests
+// read as a nested path, e.g. "cases[2].Email: arg matches pattern". Since a
+// Checker can only report one failure, Group runs every checker but reports
+// only the first one that fails; wrap each table-driven case in its own
+// Group to see every case's failure rather than just the first.
+//
+// The CheckerError is copied before its Name is rewritten: checker may
+// return a *CheckerError supplied by the caller as nameOrErr (newCheckerError
+// returns that exact pointer back unchanged), and callers can reuse the same
+// *CheckerError across multiple checkers, so mutating it in place would leak
+// one Group's prefix into every other checker sharing it.
+func Group(name string, checkers ...Checker) Checker {
+	return func() *CheckerError {
+		val := Begin().Validate(checkers...)
+		if val == nil || len(val.Errors) == 0 {
+			return nil
+		}
+		cp := *val.Errors[0]
+		if cp.Name != "" {
+			cp.Name = name + "." + cp.Name
+		} else {
+			cp.Name = name
+		}
+		return &cp
+	}
+}
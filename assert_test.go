@@ -0,0 +1,89 @@
+package vala
+
+import (
+	"fmt"
+	"testing"
+)
+
+type fakeTB struct {
+	helperCalls int
+	errorfMsgs  []string
+	fatalfMsgs  []string
+}
+
+func (f *fakeTB) Helper() { f.helperCalls++ }
+
+func (f *fakeTB) Errorf(format string, args ...interface{}) {
+	f.errorfMsgs = append(f.errorfMsgs, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeTB) Fatalf(format string, args ...interface{}) {
+	f.fatalfMsgs = append(f.fatalfMsgs, fmt.Sprintf(format, args...))
+}
+
+func TestAssert(t *testing.T) {
+	fake := &fakeTB{}
+	Assert(fake, Eq("foo", "foo", "foo"))
+	if len(fake.errorfMsgs) != 0 {
+		t.Fatalf("Received unexpected failures: %v", fake.errorfMsgs)
+	}
+
+	fake = &fakeTB{}
+	Assert(fake, Eq("foo", "bar", "foo"))
+	if len(fake.errorfMsgs) != 1 {
+		t.Fatalf("Expected exactly one failure; got %v", fake.errorfMsgs)
+	}
+	if fake.helperCalls == 0 {
+		t.Fatal("Expected Helper() to be called.")
+	}
+}
+
+func TestRequire(t *testing.T) {
+	fake := &fakeTB{}
+	Require(fake, Eq("foo", "foo", "foo"))
+	if len(fake.fatalfMsgs) != 0 {
+		t.Fatalf("Received unexpected failures: %v", fake.fatalfMsgs)
+	}
+
+	fake = &fakeTB{}
+	Require(fake, Eq("foo", "bar", "foo"))
+	if len(fake.fatalfMsgs) != 1 {
+		t.Fatalf("Expected exactly one failure; got %v", fake.fatalfMsgs)
+	}
+}
+
+func TestGroup(t *testing.T) {
+	err := Begin().Validate(
+		Group("case[0]", Eq("foo", "bar", "value")),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	if got, expected := err.(*Validation).Errors[0].Name, "case[0].value"; got != expected {
+		t.Errorf("Expected %v; got %v", expected, got)
+	}
+
+	err = Begin().Validate(
+		Group("case[1]", Eq("foo", "foo", "value")),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+}
+
+func TestGroupDoesNotLeakAcrossSharedCheckerError(t *testing.T) {
+	shared := &CheckerError{Name: "value", Err: ErrEq}
+
+	c1 := Group("case[0]", Eq(1, 2, shared))
+	c2 := Eq(3, 4, shared)
+
+	if err := c1(); err == nil || err.Name != "case[0].value" {
+		t.Fatalf("Expected c1's error to carry the group's own prefix; got %+v", err)
+	}
+	if err := c2(); err == nil || err.Name != "value" {
+		t.Fatalf("Expected c2's error to be unaffected by c1's Group; got %+v", err)
+	}
+	if shared.Name != "value" {
+		t.Fatalf("Expected the shared CheckerError to be left untouched; got %+v", shared)
+	}
+}
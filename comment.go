@@ -0,0 +1,45 @@
+package vala
+
+import "fmt"
+
+// Comment is lazily-formatted context that can be attached to a Checker via
+// Checker.WithComment, and which shows up in the resulting CheckerError's
+// Error() message when the Checker fails. Construct one with Commentf.
+type Comment struct {
+	format string
+	args   []interface{}
+}
+
+// Commentf builds a Comment from a fmt.Sprintf-style format string and
+// arguments. The format string is not expanded until the Checker it is
+// attached to actually fails, so Commentf is safe to use in hot paths that
+// are expected to pass.
+func Commentf(format string, args ...interface{}) Comment {
+	return Comment{format: format, args: args}
+}
+
+func (c Comment) String() string {
+	return fmt.Sprintf(c.format, c.args...)
+}
+
+// WithComment decorates checker with comment, attaching it to the
+// CheckerError produced on failure. The comment's format string is only
+// expanded if checker actually fails, preserving the zero-allocation happy
+// path of a passing checker.
+//
+// The CheckerError is copied before the comment is attached: checker may
+// return a *CheckerError supplied by the caller as nameOrErr (newCheckerError
+// returns that exact pointer back unchanged), and callers can reuse the same
+// *CheckerError across multiple checkers, so mutating it in place would leak
+// one call site's comment into every other checker sharing it.
+func (checker Checker) WithComment(comment Comment) Checker {
+	return func() *CheckerError {
+		err := checker()
+		if err == nil {
+			return nil
+		}
+		cp := *err
+		cp.Comment = comment.String()
+		return &cp
+	}
+}
@@ -0,0 +1,51 @@
+package vala
+
+import "testing"
+
+func TestWithComment(t *testing.T) {
+	err := Begin().Validate(
+		Eq("foo", "bar", "foo").WithComment(Commentf("iteration %d", 3)),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	cerr := err.(*Validation).Errors[0]
+	if got, expected := cerr.Comment, "iteration 3"; got != expected {
+		t.Errorf("Expected %v; got %v", expected, got)
+	}
+	if got, expected := cerr.Error(), "foo: arg1 == arg2 (comment: iteration 3)"; got != expected {
+		t.Errorf("Expected %v; got %v", expected, got)
+	}
+}
+
+type panicsOnString struct{}
+
+func (panicsOnString) String() string {
+	panic("Comment should not have been formatted on the happy path")
+}
+
+func TestWithCommentOnSuccessDoesNotFormat(t *testing.T) {
+	err := Begin().Validate(
+		Eq("foo", "foo", "foo").WithComment(Commentf("%v", panicsOnString{})),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+}
+
+func TestWithCommentDoesNotLeakAcrossSharedCheckerError(t *testing.T) {
+	shared := &CheckerError{Name: "shared", Err: ErrEq}
+
+	c1 := Eq(1, 2, shared).WithComment(Commentf("from c1"))
+	c2 := Eq(3, 4, shared)
+
+	if err := c1(); err == nil || err.Comment != "from c1" {
+		t.Fatalf("Expected c1's error to carry its own comment; got %+v", err)
+	}
+	if err := c2(); err == nil || err.Comment != "" {
+		t.Fatalf("Expected c2's error to be unaffected by c1's WithComment; got %+v", err)
+	}
+	if shared.Comment != "" {
+		t.Fatalf("Expected the shared CheckerError to be left untouched; got %+v", shared)
+	}
+}
@@ -0,0 +1,80 @@
+package vala
+
+import (
+	"cmp"
+	"time"
+)
+
+// RngG checks that the given argument is in the desired range, inclusive.
+// nameOrErr specifies the name of the parameter or a custom error. RngG
+// generalizes Rng over any ordered type, e.g. int64, uint32, float64, or
+// string.
+func RngG[T cmp.Ordered](arg, min, max T, nameOrErr interface{}) Checker {
+	return func() *CheckerError {
+		if arg < min || arg > max {
+			return newCheckerError(nameOrErr, ErrRng)
+		}
+		return nil
+	}
+}
+
+// LtG checks that the given argument is less than the given value. nameOrErr
+// specifies the name of the parameter or a custom error. LtG generalizes Lt
+// over any ordered type.
+func LtG[T cmp.Ordered](arg, value T, nameOrErr interface{}) Checker {
+	return func() *CheckerError {
+		if arg >= value {
+			return newCheckerError(nameOrErr, ErrLt)
+		}
+		return nil
+	}
+}
+
+// LeG checks that the given argument is less than or equal to the given
+// value. nameOrErr specifies the name of the parameter or a custom error.
+// LeG generalizes Le over any ordered type.
+func LeG[T cmp.Ordered](arg, value T, nameOrErr interface{}) Checker {
+	return func() *CheckerError {
+		if arg > value {
+			return newCheckerError(nameOrErr, ErrLe)
+		}
+		return nil
+	}
+}
+
+// GtG checks that the given argument is greater than the given value.
+// nameOrErr specifies the name of the parameter or a custom error. GtG
+// generalizes Gt over any ordered type.
+func GtG[T cmp.Ordered](arg, value T, nameOrErr interface{}) Checker {
+	return func() *CheckerError {
+		if arg <= value {
+			return newCheckerError(nameOrErr, ErrGt)
+		}
+		return nil
+	}
+}
+
+// GeG checks that the given argument is greater than or equal to the given
+// value. nameOrErr specifies the name of the parameter or a custom error.
+// GeG generalizes Ge over any ordered type.
+func GeG[T cmp.Ordered](arg, value T, nameOrErr interface{}) Checker {
+	return func() *CheckerError {
+		if arg < value {
+			return newCheckerError(nameOrErr, ErrGe)
+		}
+		return nil
+	}
+}
+
+// BetweenTime checks that t falls within [lo, hi], inclusive. time.Time
+// isn't cmp.Ordered (it has no < operator), so it gets its own
+// specialization built on Before/After rather than going through RngG.
+// nameOrErr specifies the name of the parameter or a custom error.
+func BetweenTime(t, lo, hi time.Time, nameOrErr interface{}) Checker {
+	return func() *CheckerError {
+		if t.Before(lo) || t.After(hi) {
+			return newCheckerError(nameOrErr, ErrRng)
+		}
+		return nil
+	}
+}
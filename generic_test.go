@@ -0,0 +1,85 @@
+package vala
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRngG(t *testing.T) {
+	tests := []struct {
+		name        string
+		checker     Checker
+		expectError bool
+	}{
+		{"int64 in range", RngG(int64(5), int64(0), int64(10), "tmp"), false},
+		{"int64 out of range", RngG(int64(11), int64(0), int64(10), "tmp"), true},
+		{"uint32 in range", RngG(uint32(5), uint32(0), uint32(10), "tmp"), false},
+		{"uint32 out of range", RngG(uint32(20), uint32(0), uint32(10), "tmp"), true},
+		{"float64 in range", RngG(1.5, 1.0, 2.0, "tmp"), false},
+		{"float64 out of range", RngG(2.5, 1.0, 2.0, "tmp"), true},
+		{"string in range", RngG("b", "a", "c", "tmp"), false},
+		{"string out of range", RngG("z", "a", "c", "tmp"), true},
+	}
+	for _, test := range tests {
+		err := Begin().Validate(test.checker).Check()
+		if test.expectError && err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+		if !test.expectError && err != nil {
+			t.Errorf("%s: received an unexpected error: %v", test.name, err)
+		}
+	}
+}
+
+func TestLtGLeGGtGGeG(t *testing.T) {
+	err := Begin().Validate(
+		LtG(int64(1), int64(2), "tmpA"),
+		LeG(uint32(2), uint32(2), "tmpB"),
+		GtG(2.5, 1.0, "tmpC"),
+		GeG("b", "a", "tmpD"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		LtG(int64(2), int64(1), "tmpA"),
+		LeG(uint32(3), uint32(2), "tmpB"),
+		GtG(1.0, 2.5, "tmpC"),
+		GeG("a", "b", "tmpD"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	if got, expected := len(err.(*Validation).Errors), 4; got != expected {
+		t.Fatalf("Expected %v errors; got %v", expected, got)
+	}
+}
+
+func TestBetweenTime(t *testing.T) {
+	lo := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	hi := time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	err := Begin().Validate(
+		BetweenTime(time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), lo, hi, "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		BetweenTime(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), lo, hi, "tmpB"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+}
+
+func TestLtBackwardsCompatible(t *testing.T) {
+	err := Begin().Validate(
+		Lt(0, 1, "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+}
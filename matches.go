@@ -0,0 +1,130 @@
+package vala
+
+import (
+	"errors"
+	"net/netip"
+	"regexp"
+	"sync"
+)
+
+// ErrMatches is returned when the argument does not match the given pattern.
+var ErrMatches = errors.New("arg matches pattern")
+
+// patternCache holds compiled regular expressions keyed by their source
+// pattern string, so that repeated calls to Matches with the same pattern
+// in hot paths don't pay for recompilation.
+var patternCache sync.Map
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if cached, ok := patternCache.Load(pattern); ok {
+		return cached.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := patternCache.LoadOrStore(pattern, re)
+	return actual.(*regexp.Regexp), nil
+}
+
+// Matches checks that arg fully matches the given pattern, anchoring it with
+// "^...$" semantics. Compiled patterns are cached so repeated validations
+// against the same pattern don't recompile it. nameOrErr specifies the name
+// of the parameter or a custom error.
+//
+// If pattern fails to compile, Matches panics, since that represents a
+// programming error rather than a validation failure.
+func Matches(arg string, pattern string, nameOrErr interface{}) Checker {
+	re, err := compilePattern(pattern)
+	if err != nil {
+		panic(err)
+	}
+	return MatchesRegexp(arg, re, nameOrErr)
+}
+
+// MatchesRegexp is like Matches, but takes an already-compiled *regexp.Regexp
+// instead of a pattern string. This avoids both the compilation and the
+// cache lookup, and is what the Email, URL, UUID, Hostname and Hex checkers
+// are built on top of. re is used as given: no "^...$" anchoring is added,
+// so an unanchored re passes on a partial match. nameOrErr specifies the
+// name of the parameter or a custom error.
+func MatchesRegexp(arg string, re *regexp.Regexp, nameOrErr interface{}) Checker {
+	return func() *CheckerError {
+		if re.MatchString(arg) {
+			return nil
+		}
+		return newCheckerError(nameOrErr, ErrMatches)
+	}
+}
+
+// NotMatches is the inverse of Matches: it checks that arg does not fully
+// match the given pattern. nameOrErr specifies the name of the parameter or
+// a custom error.
+func NotMatches(arg string, pattern string, nameOrErr interface{}) Checker {
+	return Not(Matches(arg, pattern, nameOrErr), nameOrErr)
+}
+
+var (
+	emailPattern    = regexp.MustCompile(`^[^\s@]+@[^\s@]+\.[^\s@]+$`)
+	urlPattern      = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*://[^\s]+$`)
+	uuidPattern     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	hostnamePattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
+	hexPattern      = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+)
+
+// Email checks that arg is a syntactically valid email address. nameOrErr
+// specifies the name of the parameter or a custom error.
+func Email(arg string, nameOrErr interface{}) Checker {
+	return MatchesRegexp(arg, emailPattern, nameOrErr)
+}
+
+// URL checks that arg is a syntactically valid, absolute URL. nameOrErr
+// specifies the name of the parameter or a custom error.
+func URL(arg string, nameOrErr interface{}) Checker {
+	return MatchesRegexp(arg, urlPattern, nameOrErr)
+}
+
+// UUID checks that arg is a syntactically valid UUID, e.g.
+// "123e4567-e89b-12d3-a456-426614174000". nameOrErr specifies the name of
+// the parameter or a custom error.
+func UUID(arg string, nameOrErr interface{}) Checker {
+	return MatchesRegexp(arg, uuidPattern, nameOrErr)
+}
+
+// IPv4 checks that arg is a syntactically valid dotted-decimal IPv4 address,
+// via netip.ParseAddr rather than a hand-rolled pattern. nameOrErr specifies
+// the name of the parameter or a custom error.
+func IPv4(arg string, nameOrErr interface{}) Checker {
+	return func() *CheckerError {
+		addr, err := netip.ParseAddr(arg)
+		if err != nil || !addr.Is4() {
+			return newCheckerError(nameOrErr, ErrMatches)
+		}
+		return nil
+	}
+}
+
+// IPv6 checks that arg is a syntactically valid IPv6 address, via
+// netip.ParseAddr rather than a hand-rolled pattern. nameOrErr specifies the
+// name of the parameter or a custom error.
+func IPv6(arg string, nameOrErr interface{}) Checker {
+	return func() *CheckerError {
+		addr, err := netip.ParseAddr(arg)
+		if err != nil || !addr.Is6() {
+			return newCheckerError(nameOrErr, ErrMatches)
+		}
+		return nil
+	}
+}
+
+// Hostname checks that arg is a syntactically valid DNS hostname. nameOrErr
+// specifies the name of the parameter or a custom error.
+func Hostname(arg string, nameOrErr interface{}) Checker {
+	return MatchesRegexp(arg, hostnamePattern, nameOrErr)
+}
+
+// Hex checks that arg consists only of hexadecimal digits. nameOrErr
+// specifies the name of the parameter or a custom error.
+func Hex(arg string, nameOrErr interface{}) Checker {
+	return MatchesRegexp(arg, hexPattern, nameOrErr)
+}
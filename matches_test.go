@@ -0,0 +1,174 @@
+package vala
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestMatches(t *testing.T) {
+	err := Begin().Validate(
+		Matches("hello", "h.*o", "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		Matches("hello world", "h.*o", "tmpB"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	if got, expected := err.(*Validation).Errors[0].Err, ErrMatches; got != expected {
+		t.Errorf("Expected %v; got %v", expected, got)
+	}
+}
+
+func TestMatchesCachesCompiledPattern(t *testing.T) {
+	pattern := "^cache-me$"
+	Matches("cache-me", pattern, "tmpA")
+
+	re, ok := patternCache.Load(pattern)
+	if !ok {
+		t.Fatal("Expected pattern to be cached.")
+	}
+	if !re.(*regexp.Regexp).MatchString("cache-me") {
+		t.Fatal("Cached pattern does not match expected input.")
+	}
+}
+
+func TestNotMatches(t *testing.T) {
+	err := Begin().Validate(
+		NotMatches("hello", "goodbye", "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		NotMatches("hello", "hello", "tmpB"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+}
+
+func TestEmail(t *testing.T) {
+	err := Begin().Validate(
+		Email("user@example.com", "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		Email("not-an-email", "tmpB"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+}
+
+func TestURL(t *testing.T) {
+	err := Begin().Validate(
+		URL("https://example.com/path", "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		URL("not a url", "tmpB"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+}
+
+func TestUUID(t *testing.T) {
+	err := Begin().Validate(
+		UUID("123e4567-e89b-12d3-a456-426614174000", "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		UUID("not-a-uuid", "tmpB"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+}
+
+func TestIPv4(t *testing.T) {
+	err := Begin().Validate(
+		IPv4("192.168.1.1", "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		IPv4("999.999.999.999", "tmpB"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+}
+
+func TestIPv6(t *testing.T) {
+	err := Begin().Validate(
+		IPv6("2001:db8::1", "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	for _, invalid := range []string{
+		"not-an-ipv6-address",
+		"f:",
+		"12345::1",
+		"1:2:3:4:5:6:7:8:9",
+		"192.168.1.1",
+	} {
+		err = Begin().Validate(
+			IPv6(invalid, "tmpB"),
+		).Check()
+		if err == nil {
+			t.Errorf("%q: expected an error.", invalid)
+		}
+	}
+}
+
+func TestHostname(t *testing.T) {
+	err := Begin().Validate(
+		Hostname("example.com", "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		Hostname("not a hostname!", "tmpB"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+}
+
+func TestHex(t *testing.T) {
+	err := Begin().Validate(
+		Hex("deadBEEF0123", "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		Hex("not-hex", "tmpB"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+}
@@ -0,0 +1,83 @@
+package vala
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	ErrPanic           = errors.New("fn panicked with an unexpected value")
+	ErrNoPanic         = errors.New("fn did not panic")
+	ErrUnexpectedPanic = errors.New("fn panicked unexpectedly")
+)
+
+// Panics checks that calling fn results in a panic whose recovered value
+// matches expected. Two error values match if their Error() strings are
+// equal; any other pair of values matches via reflect.DeepEqual. A nil
+// expected matches any panic at all. nameOrErr specifies the name of the
+// parameter or a custom error.
+func Panics(fn func(), expected interface{}, nameOrErr interface{}) Checker {
+	return func() (checkerErr *CheckerError) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				checkerErr = newCheckerError(nameOrErr, ErrNoPanic)
+				return
+			}
+			if expected == nil || panicValuesEqual(recovered, expected) {
+				checkerErr = nil
+				return
+			}
+			checkerErr = newCheckerError(nameOrErr, ErrPanic)
+		}()
+		fn()
+		return nil
+	}
+}
+
+// DoesNotPanic checks that calling fn does not panic. nameOrErr specifies
+// the name of the parameter or a custom error.
+func DoesNotPanic(fn func(), nameOrErr interface{}) Checker {
+	return func() (checkerErr *CheckerError) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				checkerErr = newCheckerError(nameOrErr, ErrUnexpectedPanic)
+			}
+		}()
+		fn()
+		return nil
+	}
+}
+
+func panicValuesEqual(recovered, expected interface{}) bool {
+	recoveredErr, recoveredIsErr := recovered.(error)
+	expectedErr, expectedIsErr := expected.(error)
+	if recoveredIsErr && expectedIsErr {
+		return recoveredErr.Error() == expectedErr.Error()
+	}
+	return reflect.DeepEqual(recovered, expected)
+}
+
+// CheckAndRecover runs checkers exactly as Validate().Check() would,
+// aggregating their errors into a single error, but also recovers from any
+// panic raised while running them and turns it into a plain error return
+// instead of an unhandled panic.
+//
+// This has to take the checkers directly, rather than being a method
+// hanging off an already-built *Validation like Check and CheckAndPanic
+// are: a panicking Checker panics while Validate(checkers...) is
+// evaluated, which Go does before a chained .CheckAndRecover() is ever
+// entered, so a recover installed there would be too late to catch it.
+func CheckAndRecover(checkers ...Checker) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			if recoveredErr, ok := recovered.(error); ok {
+				err = recoveredErr
+				return
+			}
+			err = fmt.Errorf("%v", recovered)
+		}
+	}()
+	return Begin().Validate(checkers...).Check()
+}
@@ -0,0 +1,103 @@
+package vala
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPanics(t *testing.T) {
+	err := Begin().Validate(
+		Panics(func() { panic("boom") }, "boom", "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		Panics(func() { panic("boom") }, "bang", "tmpB"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+
+	err = Begin().Validate(
+		Panics(func() { panic(myErr) }, errors.New("My custom error"), "tmpC"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		Panics(func() { panic("anything") }, nil, "tmpD"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		Panics(func() {}, "boom", "tmpE"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	if got, expected := err.(*Validation).Errors[0].Err, ErrNoPanic; got != expected {
+		t.Errorf("Expected %v; got %v", expected, got)
+	}
+}
+
+func TestDoesNotPanic(t *testing.T) {
+	err := Begin().Validate(
+		DoesNotPanic(func() {}, "tmpA"),
+	).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err = Begin().Validate(
+		DoesNotPanic(func() { panic("boom") }, "tmpB"),
+	).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	if got, expected := err.(*Validation).Errors[0].Err, ErrUnexpectedPanic; got != expected {
+		t.Errorf("Expected %v; got %v", expected, got)
+	}
+}
+
+func TestCheckAndRecover(t *testing.T) {
+	err := CheckAndRecover(
+		Eq("foo", "bar", "foo"),
+	)
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+
+	err = CheckAndRecover(
+		Eq("foo", "foo", "foo"),
+	)
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+}
+
+func TestCheckAndRecoverRecoversFromPanickingChecker(t *testing.T) {
+	err := CheckAndRecover(
+		func() *CheckerError { panic(myErr) },
+	)
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	if err != myErr {
+		t.Errorf("Expected %v; got %v", myErr, err)
+	}
+
+	err = CheckAndRecover(
+		func() *CheckerError { panic("boom") },
+	)
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	if got, expected := err.Error(), "boom"; got != expected {
+		t.Errorf("Expected %v; got %v", expected, got)
+	}
+}
@@ -0,0 +1,319 @@
+package vala
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownRule is wrapped (check with errors.Is) by the CheckerError that
+// Struct reports when a `vala:"..."` struct tag names a rule that was never
+// registered via Register, or applies a built-in rule to a field kind that
+// rule does not support.
+var ErrUnknownRule = errors.New("vala: unknown struct tag rule")
+
+// TagCheckerFactory builds a Checker for a single rule out of a `vala:"..."`
+// struct tag. field is the reflected struct field the rule was declared on,
+// args are the rule's colon-separated arguments (e.g. "rng=1:64" yields
+// args = []string{"1", "64"}), and name is the field's display name, i.e.
+// the struct field name unless overridden with a "name=Foo" rule.
+type TagCheckerFactory func(field reflect.Value, args []string, name string) Checker
+
+var tagRegistry = struct {
+	sync.RWMutex
+	factories map[string]TagCheckerFactory
+}{factories: map[string]TagCheckerFactory{}}
+
+// Register adds a named rule that can be referenced from a `vala:"..."`
+// struct tag, for use by Struct. Registering a name that already exists
+// replaces it.
+func Register(name string, factory TagCheckerFactory) {
+	tagRegistry.Lock()
+	defer tagRegistry.Unlock()
+	tagRegistry.factories[name] = factory
+}
+
+func lookupRule(name string) (TagCheckerFactory, bool) {
+	tagRegistry.RLock()
+	defer tagRegistry.RUnlock()
+	factory, ok := tagRegistry.factories[name]
+	return factory, ok
+}
+
+func init() {
+	Register("notempty", func(field reflect.Value, args []string, name string) Checker {
+		return NotEmpty(fieldAsString(field), name)
+	})
+	Register("eq", func(field reflect.Value, args []string, name string) Checker {
+		return Eq(fieldAsString(field), tagArg(args, 0), name)
+	})
+	Register("ne", func(field reflect.Value, args []string, name string) Checker {
+		return Ne(fieldAsString(field), tagArg(args, 0), name)
+	})
+	Register("rng", func(field reflect.Value, args []string, name string) Checker {
+		minStr, maxStr := tagArg(args, 0), tagArg(args, 1)
+		return numericRule(field, name,
+			func(n int) Checker {
+				min, _ := strconv.Atoi(minStr)
+				max, _ := strconv.Atoi(maxStr)
+				return Rng(n, min, max, name)
+			},
+			func(f float64) Checker {
+				min, _ := strconv.ParseFloat(minStr, 64)
+				max, _ := strconv.ParseFloat(maxStr, 64)
+				return RngG(f, min, max, name)
+			},
+		)
+	})
+	Register("lt", func(field reflect.Value, args []string, name string) Checker {
+		valueStr := tagArg(args, 0)
+		return numericRule(field, name,
+			func(n int) Checker {
+				value, _ := strconv.Atoi(valueStr)
+				return Lt(n, value, name)
+			},
+			func(f float64) Checker {
+				value, _ := strconv.ParseFloat(valueStr, 64)
+				return LtG(f, value, name)
+			},
+		)
+	})
+	Register("le", func(field reflect.Value, args []string, name string) Checker {
+		valueStr := tagArg(args, 0)
+		return numericRule(field, name,
+			func(n int) Checker {
+				value, _ := strconv.Atoi(valueStr)
+				return Le(n, value, name)
+			},
+			func(f float64) Checker {
+				value, _ := strconv.ParseFloat(valueStr, 64)
+				return LeG(f, value, name)
+			},
+		)
+	})
+	Register("gt", func(field reflect.Value, args []string, name string) Checker {
+		valueStr := tagArg(args, 0)
+		return numericRule(field, name,
+			func(n int) Checker {
+				value, _ := strconv.Atoi(valueStr)
+				return Gt(n, value, name)
+			},
+			func(f float64) Checker {
+				value, _ := strconv.ParseFloat(valueStr, 64)
+				return GtG(f, value, name)
+			},
+		)
+	})
+	Register("ge", func(field reflect.Value, args []string, name string) Checker {
+		valueStr := tagArg(args, 0)
+		return numericRule(field, name,
+			func(n int) Checker {
+				value, _ := strconv.Atoi(valueStr)
+				return Ge(n, value, name)
+			},
+			func(f float64) Checker {
+				value, _ := strconv.ParseFloat(valueStr, 64)
+				return GeG(f, value, name)
+			},
+		)
+	})
+	Register("bool", func(field reflect.Value, args []string, name string) Checker {
+		return Bool(fieldAsString(field), name)
+	})
+	Register("int", func(field reflect.Value, args []string, name string) Checker {
+		bits, _ := strconv.Atoi(tagArg(args, 0))
+		return Int(fieldAsString(field), bits, name)
+	})
+	Register("uint", func(field reflect.Value, args []string, name string) Checker {
+		bits, _ := strconv.Atoi(tagArg(args, 0))
+		return Uint(fieldAsString(field), bits, name)
+	})
+	Register("float", func(field reflect.Value, args []string, name string) Checker {
+		bits, _ := strconv.Atoi(tagArg(args, 0))
+		return Float(fieldAsString(field), bits, name)
+	})
+	Register("notnil", func(field reflect.Value, args []string, name string) Checker {
+		switch field.Kind() {
+		case
+			reflect.String,
+			reflect.Chan,
+			reflect.Func,
+			reflect.Interface,
+			reflect.Map,
+			reflect.Ptr,
+			reflect.Slice:
+			return NotNil(field.Interface(), name)
+		default:
+			return unsupportedKindChecker(field, name)
+		}
+	})
+}
+
+func tagArg(args []string, i int) string {
+	if i < len(args) {
+		return args[i]
+	}
+	return ""
+}
+
+func fieldAsString(field reflect.Value) string {
+	if field.Kind() == reflect.String {
+		return field.String()
+	}
+	return fmt.Sprint(field.Interface())
+}
+
+// fieldAsInt extracts an int out of field for the rng/lt/le/gt/ge rules:
+// the length for strings/slices/arrays/maps, or the value itself for
+// integer kinds. ok is false for any other kind, notably floats, which
+// numericRule routes through the float path instead.
+func fieldAsInt(field reflect.Value) (n int, ok bool) {
+	switch field.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return field.Len(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(field.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(field.Uint()), true
+	default:
+		return 0, false
+	}
+}
+
+// numericRule dispatches a rng/lt/le/gt/ge rule to intRule for
+// string/slice/array/map/int/uint fields (via fieldAsInt) or to floatRule
+// for float32/float64 fields. Any other kind produces an explicit
+// ErrUnknownRule-wrapping CheckerError rather than silently checking
+// against a wrong default value.
+func numericRule(field reflect.Value, name string, intRule func(int) Checker, floatRule func(float64) Checker) Checker {
+	switch field.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return floatRule(field.Float())
+	default:
+		if n, ok := fieldAsInt(field); ok {
+			return intRule(n)
+		}
+		return unsupportedKindChecker(field, name)
+	}
+}
+
+func unsupportedKindChecker(field reflect.Value, name string) Checker {
+	return func() *CheckerError {
+		return newCheckerError(name, fmt.Errorf("%w: unsupported field kind %s for this rule", ErrUnknownRule, field.Kind()))
+	}
+}
+
+// tagRule is a single parsed rule out of a `vala:"..."` struct tag, e.g.
+// "rng=1:64" parses to tagRule{Name: "rng", Args: []string{"1", "64"}}.
+type tagRule struct {
+	Name string
+	Args []string
+}
+
+// parseTag splits a `vala:"..."` tag into its individual rules, pulling out
+// a "name=Foo" rule (if any) to override defaultName rather than treating it
+// as a Checker rule.
+func parseTag(tag string, defaultName string) ([]tagRule, string) {
+	name := defaultName
+	parts := strings.Split(tag, ",")
+	rules := make([]tagRule, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key := part
+		var args []string
+		if idx := strings.IndexByte(part, '='); idx >= 0 {
+			key = part[:idx]
+			args = strings.Split(part[idx+1:], ":")
+		}
+		if key == "name" && len(args) == 1 {
+			name = args[0]
+			continue
+		}
+		rules = append(rules, tagRule{Name: key, Args: args})
+	}
+	return rules, name
+}
+
+// Struct walks the exported fields of the struct pointed to (or held) by v,
+// running the Checkers described by each field's `vala:"..."` tag, and
+// recursing into nested structs. The returned *Validation behaves exactly
+// like the one built by Begin().Validate(...): nil when everything passed,
+// otherwise ready for Check(), CheckAndPanic(), and friends.
+//
+// Unknown rule names produce a CheckerError wrapping ErrUnknownRule rather
+// than panicking, so a typo in a tag surfaces the same way any other
+// validation failure would.
+func Struct(v interface{}) *Validation {
+	return walkStruct(reflect.ValueOf(v), map[uintptr]bool{})
+}
+
+// walkStruct recurses depth-first, tracking visited in-progress pointers so
+// a genuine cycle (a struct reachable from itself) stops recursion. visited
+// only reflects the current path: each pointer is unmarked via defer once
+// its subtree finishes, so two sibling fields that legitimately point at
+// the same shared instance (a DAG, not a cycle) are each walked and
+// reported on independently.
+func walkStruct(v reflect.Value, visited map[uintptr]bool) *Validation {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		ptr := v.Pointer()
+		if visited[ptr] {
+			return nil
+		}
+		visited[ptr] = true
+		defer delete(visited, ptr)
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var val *Validation
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+		fv := v.Field(i)
+		name := sf.Name
+		var rules []tagRule
+		if tag := sf.Tag.Get("vala"); tag != "" {
+			rules, name = parseTag(tag, name)
+		}
+
+		for _, rule := range rules {
+			factory, ok := lookupRule(rule.Name)
+			if !ok {
+				rule := rule
+				val = val.Validate(func() *CheckerError {
+					return newCheckerError(name, fmt.Errorf("%w: %q", ErrUnknownRule, rule.Name))
+				})
+				continue
+			}
+			val = val.Validate(factory(fv, rule.Args, name))
+		}
+
+		val = appendValidation(val, walkStruct(fv, visited))
+	}
+	return val
+}
+
+func appendValidation(dst, src *Validation) *Validation {
+	if src == nil || len(src.Errors) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = validationFactory()
+	}
+	dst.Errors = append(dst.Errors, src.Errors...)
+	return dst
+}
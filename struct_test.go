@@ -0,0 +1,146 @@
+package vala
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type structAddress struct {
+	City string `vala:"notempty"`
+}
+
+type structPerson struct {
+	Name    string `vala:"notempty,rng=1:64"`
+	Age     int    `vala:"rng=0:150"`
+	Email   string `vala:"matches=^[^@]+@[^@]+$,name=Email Address"`
+	Address structAddress
+	Parent  *structPerson
+}
+
+func init() {
+	Register("matches", func(field reflect.Value, args []string, name string) Checker {
+		return Matches(fieldAsString(field), args[0], name)
+	})
+}
+
+func TestStructValid(t *testing.T) {
+	p := structPerson{
+		Name:    "Ada",
+		Age:     36,
+		Email:   "ada@example.com",
+		Address: structAddress{City: "London"},
+	}
+	if err := Struct(&p).Check(); err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+}
+
+func TestStructInvalid(t *testing.T) {
+	p := structPerson{
+		Name:    "",
+		Age:     200,
+		Email:   "not-an-email",
+		Address: structAddress{City: ""},
+	}
+	err := Struct(&p).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	if got, expected := len(err.(*Validation).Errors), 5; got != expected {
+		t.Fatalf("Expected %v errors; got %v", expected, got)
+	}
+}
+
+func TestStructNameOverride(t *testing.T) {
+	p := structPerson{Name: "Ada", Age: 36, Email: "not-an-email", Address: structAddress{City: "London"}}
+	err := Struct(&p).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	if got, expected := err.(*Validation).Errors[0].Name, "Email Address"; got != expected {
+		t.Errorf("Expected %v; got %v", expected, got)
+	}
+}
+
+func TestStructCycle(t *testing.T) {
+	p := &structPerson{Name: "Ada", Age: 36, Email: "ada@example.com", Address: structAddress{City: "London"}}
+	p.Parent = p
+
+	err := Struct(p).Check()
+	if err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+}
+
+func TestStructUnknownRule(t *testing.T) {
+	type withBadTag struct {
+		Field string `vala:"not-a-real-rule"`
+	}
+	err := Struct(&withBadTag{Field: "x"}).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+}
+
+func TestStructSharedChildIsNotTreatedAsACycle(t *testing.T) {
+	type child struct {
+		Name string `vala:"notempty"`
+	}
+	type parent struct {
+		A *child
+		B *child
+	}
+
+	shared := &child{Name: ""}
+	p := &parent{A: shared, B: shared}
+
+	err := Struct(p).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	if got, expected := len(err.(*Validation).Errors), 2; got != expected {
+		t.Fatalf("Expected %v errors (one per field sharing the instance); got %v", expected, got)
+	}
+}
+
+func TestStructFloatField(t *testing.T) {
+	type withScore struct {
+		Score float64 `vala:"rng=1:10"`
+	}
+
+	if err := Struct(&withScore{Score: 5.0}).Check(); err != nil {
+		t.Fatalf("Received an unexpected error: %v", err)
+	}
+
+	err := Struct(&withScore{Score: 20.0}).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+}
+
+func TestStructUnsupportedKindForNumericRule(t *testing.T) {
+	type withBadRng struct {
+		Flag bool `vala:"rng=1:10"`
+	}
+	err := Struct(&withBadRng{Flag: true}).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	if got := err.(*Validation).Errors[0].Err; !errors.Is(got, ErrUnknownRule) {
+		t.Errorf("Expected error to wrap %v; got %v", ErrUnknownRule, got)
+	}
+}
+
+func TestStructUnsupportedKindForNotNilRule(t *testing.T) {
+	type withBadNotNil struct {
+		Count int `vala:"notnil"`
+	}
+	err := Struct(&withBadNotNil{Count: 1}).Check()
+	if err == nil {
+		t.Fatal("Expected an error.")
+	}
+	if got := err.(*Validation).Errors[0].Err; !errors.Is(got, ErrUnknownRule) {
+		t.Errorf("Expected error to wrap %v; got %v", ErrUnknownRule, got)
+	}
+}
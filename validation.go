@@ -13,13 +13,19 @@ type CheckerError struct {
 	// Optional parameter name
 	Name string
 	Err  error
+	// Optional, lazily-formatted context attached via Checker.WithComment.
+	Comment string
 }
 
 func (err *CheckerError) Error() string {
+	msg := err.Err.Error()
 	if err.Name != "" {
-		return fmt.Sprintf("%s: %s", err.Name, err.Err)
+		msg = fmt.Sprintf("%s: %s", err.Name, msg)
 	}
-	return err.Err.Error()
+	if err.Comment != "" {
+		msg = fmt.Sprintf("%s (comment: %s)", msg, err.Comment)
+	}
+	return msg
 }
 
 var (
@@ -191,58 +197,48 @@ func NotNil(arg interface{}, nameOrErr interface{}) Checker {
 
 // Rng checks that the given argument is in the desired range. nameOrErr
 // specifies the name of the parameter or a custom error.
+//
+// Rng is a thin wrapper over RngG for int, kept for backwards
+// compatibility; new code working with other ordered types should call
+// RngG directly.
 func Rng(arg int, min, max int, nameOrErr interface{}) Checker {
-	return func() *CheckerError {
-		len := arg
-		if len < min || len > max {
-			return newCheckerError(nameOrErr, ErrRng)
-		}
-		return nil
-	}
+	return RngG(arg, min, max, nameOrErr)
 }
 
 // Lt checks that the given argument is less than the given value. nameOrErr
 // specifies the name of the parameter or a custom error.
+//
+// Lt is a thin wrapper over LtG for int, kept for backwards compatibility;
+// new code working with other ordered types should call LtG directly.
 func Lt(arg int, value int, nameOrErr interface{}) Checker {
-	return func() *CheckerError {
-		if arg >= value {
-			return newCheckerError(nameOrErr, ErrLt)
-		}
-		return nil
-	}
+	return LtG(arg, value, nameOrErr)
 }
 
 // Le checks that the given argument is less than or equal to the given value.
 // nameOrErr specifies the name of the parameter or a custom error.
+//
+// Le is a thin wrapper over LeG for int, kept for backwards compatibility;
+// new code working with other ordered types should call LeG directly.
 func Le(arg int, value int, nameOrErr interface{}) Checker {
-	return func() *CheckerError {
-		if arg > value {
-			return newCheckerError(nameOrErr, ErrLe)
-		}
-		return nil
-	}
+	return LeG(arg, value, nameOrErr)
 }
 
 // Gt checks that the given argument is greater than the given value.
 // nameOrErr specifies the name of the parameter or a custom error.
+//
+// Gt is a thin wrapper over GtG for int, kept for backwards compatibility;
+// new code working with other ordered types should call GtG directly.
 func Gt(arg int, value int, nameOrErr interface{}) Checker {
-	return func() *CheckerError {
-		if arg <= value {
-			return newCheckerError(nameOrErr, ErrGt)
-		}
-		return nil
-	}
+	return GtG(arg, value, nameOrErr)
 }
 
 // Ge checks that the given argument is greater than the given value.
 // nameOrErr specifies the name of the parameter or a custom error.
+//
+// Ge is a thin wrapper over GeG for int, kept for backwards compatibility;
+// new code working with other ordered types should call GeG directly.
 func Ge(arg int, value int, nameOrErr interface{}) Checker {
-	return func() *CheckerError {
-		if arg < value {
-			return newCheckerError(nameOrErr, ErrGe)
-		}
-		return nil
-	}
+	return GeG(arg, value, nameOrErr)
 }
 
 // Bool checks if the given string represents a boolean value, i.e., 1, t, T, TRUE,
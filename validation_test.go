@@ -11,14 +11,14 @@ var (
 )
 
 func TestError(t *testing.T) {
-	v := &Validation{[]error{}}
+	v := &Validation{Errors: []*CheckerError{}}
 
 	err := v.Error()
 	if err != "" {
 		t.Errorf("Received an unexpected error message: %v", err)
 	}
 
-	cerr := &CheckerError{"Name", ErrNe}
+	cerr := &CheckerError{Name: "Name", Err: ErrNe}
 	v.Errors = append(v.Errors, cerr)
 	err = v.Error()
 	if err == "" {
@@ -30,7 +30,7 @@ func TestNewCheckerError(t *testing.T) {
 	def := ErrNotEmpty
 	name := "Test"
 	err := newCheckerError(name, def)
-	if got := err.(*CheckerError).Err; got != ErrNotEmpty {
+	if got := err.Err; got != ErrNotEmpty {
 		t.Errorf("Expected %v; got %v", ErrNotEmpty, got)
 	}
 	if got, expected := err.Error(), "Test: arg != \"\""; got != expected {
@@ -39,14 +39,14 @@ func TestNewCheckerError(t *testing.T) {
 
 	customErr := ErrNotNil
 	err = newCheckerError(customErr, def)
-	if got := err.(*CheckerError).Err; got != ErrNotNil {
+	if got := err.Err; got != ErrNotNil {
 		t.Errorf("Expected %v; got %v", ErrNotNil, got)
 	}
 	if got, expected := err.Error(), "arg != nil"; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
 
-	customErr2 := &CheckerError{"Test", ErrNe}
+	customErr2 := &CheckerError{Name: "Test", Err: ErrNe}
 	err = newCheckerError(customErr2, def)
 	if err != customErr2 {
 		t.Errorf("Expected %v; got %v", customErr2, err)
@@ -54,7 +54,7 @@ func TestNewCheckerError(t *testing.T) {
 }
 
 func TestCheckerErrorError(t *testing.T) {
-	err := &CheckerError{"Test", ErrNotNil}
+	err := &CheckerError{Name: "Test", Err: ErrNotNil}
 	if got, expected := err.Error(), "Test: arg != nil"; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
@@ -198,7 +198,7 @@ func TestLen(t *testing.T) {
 		Rng(len("1"), 1, 1, "tmpC"),
 	).Check()
 	if err != nil {
-		t.Fatal("Received an unexpected error: %v", err)
+		t.Fatalf("Received an unexpected error: %v", err)
 	}
 
 	err = Begin().Validate(
@@ -212,7 +212,7 @@ func TestLen(t *testing.T) {
 		Rng(3, 2, 5, "tmpC"),
 	).Check()
 	if err != nil {
-		t.Fatal("Received an unexpected error: %v", err)
+		t.Fatalf("Received an unexpected error: %v", err)
 	}
 }
 
@@ -293,10 +293,10 @@ func TestBool(t *testing.T) {
 	if got, expected := len(err.(*Validation).Errors), 2; got != expected {
 		t.Fatalf("Expected %v errors; got %v", expected, got)
 	}
-	if got, expected := err.(*Validation).Errors[0].(*CheckerError).Err, ErrBool; got != expected {
+	if got, expected := err.(*Validation).Errors[0].Err, ErrBool; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
-	if got, expected := err.(*Validation).Errors[1].(*CheckerError).Err, ErrBool; got != expected {
+	if got, expected := err.(*Validation).Errors[1].Err, ErrBool; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
 
@@ -322,13 +322,13 @@ func TestInt(t *testing.T) {
 	if got, expected := len(err.(*Validation).Errors), 3; got != expected {
 		t.Fatalf("Expected %v errors; got %v", expected, got)
 	}
-	if got, expected := err.(*Validation).Errors[0].(*CheckerError).Err, strconv.ErrSyntax; got != expected {
+	if got, expected := err.(*Validation).Errors[0].Err, strconv.ErrSyntax; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
-	if got, expected := err.(*Validation).Errors[1].(*CheckerError).Err, strconv.ErrRange; got != expected {
+	if got, expected := err.(*Validation).Errors[1].Err, strconv.ErrRange; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
-	if got, expected := err.(*Validation).Errors[2].(*CheckerError).Err, strconv.ErrSyntax; got != expected {
+	if got, expected := err.(*Validation).Errors[2].Err, strconv.ErrSyntax; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
 
@@ -354,13 +354,13 @@ func TestUint(t *testing.T) {
 	if got, expected := len(err.(*Validation).Errors), 3; got != expected {
 		t.Fatalf("Expected %v errors; got %v", expected, got)
 	}
-	if got, expected := err.(*Validation).Errors[0].(*CheckerError).Err, strconv.ErrSyntax; got != expected {
+	if got, expected := err.(*Validation).Errors[0].Err, strconv.ErrSyntax; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
-	if got, expected := err.(*Validation).Errors[1].(*CheckerError).Err, strconv.ErrSyntax; got != expected {
+	if got, expected := err.(*Validation).Errors[1].Err, strconv.ErrSyntax; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
-	if got, expected := err.(*Validation).Errors[2].(*CheckerError).Err, strconv.ErrRange; got != expected {
+	if got, expected := err.(*Validation).Errors[2].Err, strconv.ErrRange; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
 
@@ -387,16 +387,16 @@ func TestFloat(t *testing.T) {
 	if got, expected := len(err.(*Validation).Errors), 4; got != expected {
 		t.Fatalf("Expected %v errors; got %v", expected, got)
 	}
-	if got, expected := err.(*Validation).Errors[0].(*CheckerError).Err, strconv.ErrSyntax; got != expected {
+	if got, expected := err.(*Validation).Errors[0].Err, strconv.ErrSyntax; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
-	if got, expected := err.(*Validation).Errors[1].(*CheckerError).Err, strconv.ErrSyntax; got != expected {
+	if got, expected := err.(*Validation).Errors[1].Err, strconv.ErrSyntax; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
-	if got, expected := err.(*Validation).Errors[2].(*CheckerError).Err, strconv.ErrRange; got != expected {
+	if got, expected := err.(*Validation).Errors[2].Err, strconv.ErrRange; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
-	if got, expected := err.(*Validation).Errors[3].(*CheckerError).Err, strconv.ErrSyntax; got != expected {
+	if got, expected := err.(*Validation).Errors[3].Err, strconv.ErrSyntax; got != expected {
 		t.Errorf("Expected %v; got %v", expected, got)
 	}
 
@@ -431,7 +431,7 @@ func TestNotEmpty(t *testing.T) {
 	if err == nil {
 		t.Fatalf("Expected an error")
 	}
-	if got := err.(*Validation).Errors[0].(*CheckerError).Err; got != myErr {
+	if got := err.(*Validation).Errors[0].Err; got != myErr {
 		t.Fatalf("Expected %v; got %v", myErr, got)
 	}
 }